@@ -1,25 +1,65 @@
 package gts
 
 import (
+	"cmp"
+	"errors"
+	"slices"
 	"sort"
 	"sync"
 	"time"
 )
 
+// ErrNotSorted is returned by query methods that require the slice to have been sorted ascending via SortAsc first.
+var ErrNotSorted = errors.New("gts: slice is not sorted ascending")
+
 // TimeSlice is a generic slice wrapper that supports sorting elements by a time field extracted via fieldTimeExtractor.
 // It is safe for concurrent use.
 type TimeSlice[T any] struct {
 	fieldTimeExtractor func(T) time.Time // extracts time from T
+	compare            func(a, b T) int  // ascending comparator used by SortAsc/SortDesc; defaults to comparing extracted times
 	slice              []T               // underlying slice of elements
 	mu                 sync.RWMutex      // mutex for concurrency
+	sorted             bool              // true while slice is known to be in ascending order: set by SortAsc/SortAscParallel and kept by the order-preserving Insert, InsertAll, DeleteBefore and Merge
+	parallelBuf        []T               // scratch buffer reused by sortParallel's final permutation pass across calls
+	sliceOwned         bool              // true once slice is known to no longer be the caller-supplied backing array from New, so sortParallel may safely recycle it into parallelBuf
+}
+
+// Option configures a TimeSlice at construction time. See WithComparator.
+type Option[T any] func(*TimeSlice[T])
+
+// WithComparator overrides the comparator used by SortAsc/SortDesc with compare, which
+// must return a negative number if a sorts before b, zero if they are equivalent, and a
+// positive number if a sorts after b. This lets callers add tie-breakers on top of the
+// extracted time, e.g. sort by time then by name, without wrapping TimeSlice.
+//
+// compare must still agree with ascending time order as its primary key: if
+// fieldTimeExtractor(a) is before fieldTimeExtractor(b), compare(a, b) must be
+// negative. Only the ordering among elements with equal extracted times is free to
+// differ. SearchTime, Between, First and Last locate elements purely by extracted time
+// and so only need this primary-key agreement to behave correctly; Insert, InsertAll
+// and Merge go further and use compare to place equal-time elements in the order it
+// specifies.
+func WithComparator[T any](compare func(a, b T) int) Option[T] {
+	return func(ts *TimeSlice[T]) {
+		ts.compare = compare
+	}
 }
 
 // New creates and returns a new TimeSlice instance for the given fieldTimeExtractor function.
-func New[T any](values []T, fieldTimeExtractor func(T) time.Time) *TimeSlice[T] {
-	return &TimeSlice[T]{
+// By default, ascending order compares the extracted times; pass WithComparator to
+// customize the comparison, e.g. to add a secondary sort key.
+func New[T any](values []T, fieldTimeExtractor func(T) time.Time, opts ...Option[T]) *TimeSlice[T] {
+	ts := &TimeSlice[T]{
 		fieldTimeExtractor: fieldTimeExtractor,
 		slice:              values,
 	}
+	ts.compare = func(a, b T) int {
+		return cmp.Compare(fieldTimeExtractor(a).UnixNano(), fieldTimeExtractor(b).UnixNano())
+	}
+	for _, opt := range opts {
+		opt(ts)
+	}
+	return ts
 }
 
 // Len returns the length of the underlying slice.
@@ -55,24 +95,45 @@ func (ts *TimeSlice[T]) LessDesc(i, j int) bool {
 func (ts *TimeSlice[T]) Swap(i, j int) {
 	ts.mu.Lock()
 	ts.slice[i], ts.slice[j] = ts.slice[j], ts.slice[i]
+	ts.sorted = false
 	ts.mu.Unlock()
 }
 
 // SortAsc sorts the underlying slice in ascending order according to the extracted time field (thread-safe).
 func (ts *TimeSlice[T]) SortAsc() {
 	ts.mu.Lock()
-	sort.SliceStable(ts.slice, func(i, j int) bool {
-		return ts.fieldTimeExtractor(ts.slice[i]).Before(ts.fieldTimeExtractor(ts.slice[j]))
-	})
+	ts.sortLocked(false)
 	ts.mu.Unlock()
 }
 
 // SortDesc sorts the underlying slice in descending order according to the extracted time field (thread-safe).
 func (ts *TimeSlice[T]) SortDesc() {
 	ts.mu.Lock()
-	sort.SliceStable(ts.slice, func(i, j int) bool {
-		return ts.fieldTimeExtractor(ts.slice[i]).After(ts.fieldTimeExtractor(ts.slice[j]))
-	})
+	ts.sortLocked(true)
+	ts.mu.Unlock()
+}
+
+// sortLocked sorts the underlying slice in place assuming the caller already holds ts.mu.
+func (ts *TimeSlice[T]) sortLocked(desc bool) {
+	if desc {
+		slices.SortStableFunc(ts.slice, func(a, b T) int { return -ts.compare(a, b) })
+		ts.sorted = false
+		return
+	}
+	slices.SortStableFunc(ts.slice, ts.compare)
+	ts.sorted = true
+}
+
+// SortBy sorts the underlying slice using compare for a one-off custom ordering,
+// without replacing the comparator set via New or WithComparator. Because the
+// resulting order is not guaranteed to be ascending by time, the sorted-order query
+// methods (SearchTime, Between, First, Last) return ErrNotSorted until SortAsc is
+// called again, and the mutation methods (Insert, InsertAll, DeleteBefore, Merge)
+// re-sort the slice ascending before they run.
+func (ts *TimeSlice[T]) SortBy(compare func(a, b T) int) {
+	ts.mu.Lock()
+	slices.SortStableFunc(ts.slice, compare)
+	ts.sorted = false
 	ts.mu.Unlock()
 }
 
@@ -92,5 +153,78 @@ func (ts *TimeSlice[T]) Clone() *TimeSlice[T] {
 	defer ts.mu.RUnlock()
 	newSlice := make([]T, len(ts.slice))
 	copy(newSlice, ts.slice)
-	return &TimeSlice[T]{fieldTimeExtractor: ts.fieldTimeExtractor, slice: newSlice}
+	return &TimeSlice[T]{fieldTimeExtractor: ts.fieldTimeExtractor, compare: ts.compare, slice: newSlice, sorted: ts.sorted}
+}
+
+// SearchTime returns the index of the first element whose extracted time is not before t,
+// i.e. the smallest index i such that fieldTimeExtractor(slice[i]) >= t.
+// If there is no such element, it returns Len(). The underlying slice must have been sorted
+// ascending via SortAsc beforehand; otherwise ErrNotSorted is returned as the second value.
+func (ts *TimeSlice[T]) SearchTime(t time.Time) (int, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	if !ts.sorted {
+		return 0, ErrNotSorted
+	}
+	return ts.searchTimeLocked(t), nil
+}
+
+// searchTimeLocked performs the sort.Search lookup assuming the caller already holds ts.mu.
+func (ts *TimeSlice[T]) searchTimeLocked(t time.Time) int {
+	return sort.Search(len(ts.slice), func(i int) bool {
+		return !ts.fieldTimeExtractor(ts.slice[i]).Before(t)
+	})
+}
+
+// Between returns a copy of the elements whose extracted time falls in [lo, hi).
+// The underlying slice must have been sorted ascending via SortAsc beforehand; otherwise
+// ErrNotSorted is returned as the second value.
+func (ts *TimeSlice[T]) Between(lo, hi time.Time) ([]T, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	if !ts.sorted {
+		return nil, ErrNotSorted
+	}
+	start := ts.searchTimeLocked(lo)
+	end := ts.searchTimeLocked(hi)
+	if end < start {
+		end = start
+	}
+	result := make([]T, end-start)
+	copy(result, ts.slice[start:end])
+	return result, nil
+}
+
+// First returns the first element whose extracted time equals t and whether such an element was found.
+// The underlying slice must have been sorted ascending via SortAsc beforehand; otherwise
+// ErrNotSorted is returned as the third value.
+func (ts *TimeSlice[T]) First(t time.Time) (T, bool, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	var zero T
+	if !ts.sorted {
+		return zero, false, ErrNotSorted
+	}
+	idx := ts.searchTimeLocked(t)
+	if idx >= len(ts.slice) || !ts.fieldTimeExtractor(ts.slice[idx]).Equal(t) {
+		return zero, false, nil
+	}
+	return ts.slice[idx], true, nil
+}
+
+// Last returns the last element whose extracted time equals t and whether such an element was found.
+// The underlying slice must have been sorted ascending via SortAsc beforehand; otherwise
+// ErrNotSorted is returned as the third value.
+func (ts *TimeSlice[T]) Last(t time.Time) (T, bool, error) {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	var zero T
+	if !ts.sorted {
+		return zero, false, ErrNotSorted
+	}
+	idx := ts.searchTimeLocked(t.Add(1))
+	if idx == 0 || !ts.fieldTimeExtractor(ts.slice[idx-1]).Equal(t) {
+		return zero, false, nil
+	}
+	return ts.slice[idx-1], true, nil
 }
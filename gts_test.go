@@ -2,6 +2,7 @@ package gts
 
 import (
 	"reflect"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -30,12 +31,14 @@ func generateLargeEvents(n int) []testEvent {
 	return events
 }
 
-func BenchmarkSortAsc_10(b *testing.B)    { benchmarkSortAsc(b, 10) }
-func BenchmarkSortAsc_100(b *testing.B)   { benchmarkSortAsc(b, 100) }
-func BenchmarkSortAsc_500(b *testing.B)   { benchmarkSortAsc(b, 500) }
-func BenchmarkSortAsc_1000(b *testing.B)  { benchmarkSortAsc(b, 1000) }
-func BenchmarkSortAsc_5000(b *testing.B)  { benchmarkSortAsc(b, 5000) }
-func BenchmarkSortAsc_10000(b *testing.B) { benchmarkSortAsc(b, 10000) }
+func BenchmarkSortAsc_10(b *testing.B)     { benchmarkSortAsc(b, 10) }
+func BenchmarkSortAsc_100(b *testing.B)    { benchmarkSortAsc(b, 100) }
+func BenchmarkSortAsc_500(b *testing.B)    { benchmarkSortAsc(b, 500) }
+func BenchmarkSortAsc_1000(b *testing.B)   { benchmarkSortAsc(b, 1000) }
+func BenchmarkSortAsc_5000(b *testing.B)   { benchmarkSortAsc(b, 5000) }
+func BenchmarkSortAsc_10000(b *testing.B)  { benchmarkSortAsc(b, 10000) }
+func BenchmarkSortAsc_50000(b *testing.B)  { benchmarkSortAsc(b, 50000) }
+func BenchmarkSortAsc_100000(b *testing.B) { benchmarkSortAsc(b, 100000) }
 
 func benchmarkSortAsc(b *testing.B, n int) {
 	events := generateLargeEvents(n)
@@ -166,3 +169,117 @@ func TestConcurrencySafety(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestSearchTimeRequiresSort(t *testing.T) {
+	events := []testEvent{
+		{"A", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"B", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	ts := New(events, eventTime)
+	if _, err := ts.SearchTime(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)); err != ErrNotSorted {
+		t.Errorf("SearchTime() before SortAsc: got err %v, want ErrNotSorted", err)
+	}
+	ts.SortAsc()
+	idx, err := ts.SearchTime(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("SearchTime() unexpected error: %v", err)
+	}
+	if idx != 1 {
+		t.Errorf("SearchTime() = %d, want 1", idx)
+	}
+	ts.Swap(0, 1)
+	if _, err := ts.SearchTime(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)); err != ErrNotSorted {
+		t.Errorf("SearchTime() after Swap: got err %v, want ErrNotSorted", err)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	events := []testEvent{
+		{"A", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"B", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"C", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"D", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	ts := New(events, eventTime)
+	ts.SortAsc()
+	got, err := ts.Between(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Between() unexpected error: %v", err)
+	}
+	want := []testEvent{events[1], events[2]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Between() got %v, want %v", got, want)
+	}
+}
+
+func TestFirstAndLast(t *testing.T) {
+	d1 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	d2 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []testEvent{
+		{"A", d1},
+		{"B", d1},
+		{"C", d2},
+	}
+	ts := New(events, eventTime)
+	ts.SortAsc()
+
+	first, ok, err := ts.First(d1)
+	if err != nil {
+		t.Fatalf("First() unexpected error: %v", err)
+	}
+	if !ok || first.Name != "A" {
+		t.Errorf("First(d1) = %v, %v, want A, true", first, ok)
+	}
+
+	last, ok, err := ts.Last(d1)
+	if err != nil {
+		t.Fatalf("Last() unexpected error: %v", err)
+	}
+	if !ok || last.Name != "B" {
+		t.Errorf("Last(d1) = %v, %v, want B, true", last, ok)
+	}
+
+	if _, ok, err := ts.First(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil || ok {
+		t.Errorf("First() for missing time = %v, %v, want false, nil", ok, err)
+	}
+}
+
+func TestWithComparatorSecondaryKey(t *testing.T) {
+	d := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []testEvent{
+		{"B", d},
+		{"A", d},
+		{"C", d},
+	}
+	ts := New(events, eventTime, WithComparator(func(a, b testEvent) int {
+		if c := a.Date.Compare(b.Date); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	}))
+	ts.SortAsc()
+	want := []testEvent{{"A", d}, {"B", d}, {"C", d}}
+	if !reflect.DeepEqual(ts.Items(), want) {
+		t.Errorf("SortAsc() with WithComparator got %v, want %v", ts.Items(), want)
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	events := []testEvent{
+		{"B", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"A", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"C", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	ts := New(events, eventTime)
+	ts.SortBy(func(a, b testEvent) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	want := []testEvent{
+		{"A", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"B", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"C", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(ts.Items(), want) {
+		t.Errorf("SortBy() got %v, want %v", ts.Items(), want)
+	}
+}
@@ -0,0 +1,150 @@
+package gts
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// ErrInvalidBucket is returned by NewIndex when bucket is not positive, since it is
+// used as a division step to map a time to its bucket number.
+var ErrInvalidBucket = errors.New("gts: bucket duration must be positive")
+
+// ErrBucketTooFine is returned by NewIndex when bucket is so small relative to the
+// slice's time span that the dense offsets array would require more than
+// maxIndexBuckets entries, e.g. a time.Microsecond bucket over years of data.
+var ErrBucketTooFine = errors.New("gts: bucket duration too fine for the slice's time span")
+
+// maxIndexBuckets bounds the number of entries NewIndex will allocate for offsets, so
+// that a bucket duration mismatched with the slice's time span fails fast with
+// ErrBucketTooFine instead of attempting a multi-gigabyte, or out-of-range, allocation.
+const maxIndexBuckets = 1 << 22 // 4,194,304 buckets (~32MiB of offsets on a 64-bit system)
+
+// Index is a time-bucketed rollup index built on an immutable snapshot of a sorted
+// TimeSlice[T] taken at construction time. It precomputes, at a fixed bucket
+// granularity, the offset into the snapshot where each bucket starts, giving O(1)
+// bucket lookup followed by a short binary search inside the edge bucket for repeated
+// window queries over the same dataset. Because the snapshot is private to the Index,
+// later mutation of the source TimeSlice (via Insert, DeleteBefore, Merge, ...) has no
+// effect on an already-built Index; build a fresh Index to see those changes.
+type Index[T any] struct {
+	fieldTimeExtractor func(T) time.Time // extracts time from T, copied from the source TimeSlice
+	bucket             time.Duration
+	t0                 int64 // UnixNano of the first element's extracted time
+	items              []T   // immutable snapshot of the source TimeSlice's elements, taken at build time
+	offsets            []int // offsets[b] is the index into items where bucket b starts; offsets[len(offsets)-1] == len(items)
+}
+
+// NewIndex builds an Index over a snapshot of ts at the given bucket granularity (e.g.
+// time.Hour, 24*time.Hour). ts is sorted ascending first if it is not already. bucket
+// must be positive, since it is used as a division step internally; otherwise
+// ErrInvalidBucket is returned. If bucket is so fine relative to the slice's time span
+// that the offsets array would exceed maxIndexBuckets entries, ErrBucketTooFine is
+// returned instead; pick a coarser bucket for that data.
+func NewIndex[T any](ts *TimeSlice[T], bucket time.Duration) (*Index[T], error) {
+	if bucket <= 0 {
+		return nil, ErrInvalidBucket
+	}
+
+	ts.mu.Lock()
+	if !ts.sorted {
+		ts.sortLocked(false)
+	}
+	items := make([]T, len(ts.slice))
+	copy(items, ts.slice)
+	fieldTimeExtractor := ts.fieldTimeExtractor
+	ts.mu.Unlock()
+
+	idx := &Index[T]{fieldTimeExtractor: fieldTimeExtractor, bucket: bucket, items: items}
+	n := len(items)
+	if n == 0 {
+		idx.offsets = []int{0}
+		return idx, nil
+	}
+	idx.t0 = fieldTimeExtractor(items[0]).UnixNano()
+
+	lastBucket := idx.bucketOf(fieldTimeExtractor(items[n-1]).UnixNano())
+	if lastBucket+2 > maxIndexBuckets {
+		return nil, ErrBucketTooFine
+	}
+	idx.offsets = make([]int, lastBucket+2)
+	b := 0
+	for i := 0; i < n; i++ {
+		eb := idx.bucketOf(fieldTimeExtractor(items[i]).UnixNano())
+		for b <= eb {
+			idx.offsets[b] = i
+			b++
+		}
+	}
+	for ; b < len(idx.offsets); b++ {
+		idx.offsets[b] = n
+	}
+	return idx, nil
+}
+
+// bucketOf returns the bucket number that unixNano falls into, relative to idx.t0.
+func (idx *Index[T]) bucketOf(unixNano int64) int {
+	return int((unixNano - idx.t0) / int64(idx.bucket))
+}
+
+// lowerBound returns the index into idx.items of the first element whose extracted
+// time is not before t.
+func (idx *Index[T]) lowerBound(t time.Time) int {
+	n := len(idx.items)
+	if n == 0 {
+		return 0
+	}
+	b := idx.bucketOf(t.UnixNano())
+	switch {
+	case b < 0:
+		return 0
+	case b >= len(idx.offsets)-1:
+		return n
+	}
+	lo, hi := idx.offsets[b], idx.offsets[b+1]
+	items := idx.items
+	return lo + sort.Search(hi-lo, func(i int) bool {
+		return !idx.fieldTimeExtractor(items[lo+i]).Before(t)
+	})
+}
+
+// windowBounds returns the [start, end) indices into idx.items of the elements whose
+// extracted time falls in [lo, hi), shared by Window, CountWindow and Aggregate so they
+// agree on bound computation without each re-deriving it from lowerBound.
+func (idx *Index[T]) windowBounds(lo, hi time.Time) (start, end int) {
+	start = idx.lowerBound(lo)
+	end = idx.lowerBound(hi)
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+// Window returns a copy of the elements whose extracted time falls in [lo, hi).
+func (idx *Index[T]) Window(lo, hi time.Time) []T {
+	start, end := idx.windowBounds(lo, hi)
+	result := make([]T, end-start)
+	copy(result, idx.items[start:end])
+	return result
+}
+
+// CountWindow returns the number of elements whose extracted time falls in [lo, hi).
+func (idx *Index[T]) CountWindow(lo, hi time.Time) int {
+	start, end := idx.windowBounds(lo, hi)
+	return end - start
+}
+
+// Aggregate folds reduce over every element of idx whose extracted time falls in
+// [lo, hi), starting from zero and proceeding in ascending time order. It is a
+// package-level function rather than a method on Index[T] because Go methods cannot
+// introduce additional type parameters beyond the receiver's. It iterates idx.items
+// directly rather than going through Window, so repeated aggregation over the same
+// index avoids an intermediate copy of the windowed elements.
+func Aggregate[T, U any](idx *Index[T], lo, hi time.Time, reduce func(U, T) U, zero U) U {
+	start, end := idx.windowBounds(lo, hi)
+	acc := zero
+	for _, v := range idx.items[start:end] {
+		acc = reduce(acc, v)
+	}
+	return acc
+}
@@ -0,0 +1,119 @@
+package gts
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIndexWindow(t *testing.T) {
+	events := generateLargeEvents(240) // one every hour across 10 days
+	ts := New(events, eventTime)
+	idx, err := NewIndex(ts, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("NewIndex() unexpected error: %v", err)
+	}
+
+	lo := time.Date(2020, 1, 2, 6, 0, 0, 0, time.UTC)
+	hi := time.Date(2020, 1, 4, 0, 0, 0, 0, time.UTC)
+	got := idx.Window(lo, hi)
+
+	var want []testEvent
+	for _, e := range events {
+		if !e.Date.Before(lo) && e.Date.Before(hi) {
+			want = append(want, e)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Window() got %d items, want %d items", len(got), len(want))
+	}
+}
+
+func TestIndexCountWindow(t *testing.T) {
+	events := generateLargeEvents(240)
+	ts := New(events, eventTime)
+	idx, err := NewIndex(ts, time.Hour)
+	if err != nil {
+		t.Fatalf("NewIndex() unexpected error: %v", err)
+	}
+
+	lo := time.Date(2020, 1, 1, 5, 0, 0, 0, time.UTC)
+	hi := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	if got := idx.CountWindow(lo, hi); got != 5 {
+		t.Errorf("CountWindow() = %d, want 5", got)
+	}
+}
+
+func TestIndexAggregate(t *testing.T) {
+	events := generateLargeEvents(24)
+	ts := New(events, eventTime)
+	idx, err := NewIndex(ts, time.Hour)
+	if err != nil {
+		t.Fatalf("NewIndex() unexpected error: %v", err)
+	}
+
+	lo := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	hi := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	count := Aggregate(idx, lo, hi, func(acc int, _ testEvent) int {
+		return acc + 1
+	}, 0)
+	if count != 12 {
+		t.Errorf("Aggregate() = %d, want 12", count)
+	}
+}
+
+func TestIndexSurvivesSourceMutation(t *testing.T) {
+	events := generateLargeEvents(240)
+	ts := New(events, eventTime)
+	idx, err := NewIndex(ts, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("NewIndex() unexpected error: %v", err)
+	}
+
+	ts.DeleteBefore(time.Date(2020, 1, 9, 0, 0, 0, 0, time.UTC))
+
+	lo := time.Date(2020, 1, 2, 6, 0, 0, 0, time.UTC)
+	hi := time.Date(2020, 1, 4, 0, 0, 0, 0, time.UTC)
+	got := idx.Window(lo, hi)
+
+	var want []testEvent
+	for _, e := range events {
+		if !e.Date.Before(lo) && e.Date.Before(hi) {
+			want = append(want, e)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Window() after source mutation got %d items, want %d items", len(got), len(want))
+	}
+}
+
+func TestIndexEmpty(t *testing.T) {
+	ts := New([]testEvent{}, eventTime)
+	idx, err := NewIndex(ts, time.Hour)
+	if err != nil {
+		t.Fatalf("NewIndex() unexpected error: %v", err)
+	}
+	if got := idx.CountWindow(time.Now(), time.Now()); got != 0 {
+		t.Errorf("CountWindow() on empty index = %d, want 0", got)
+	}
+}
+
+func TestNewIndexInvalidBucket(t *testing.T) {
+	ts := New(generateLargeEvents(10), eventTime)
+	for _, bucket := range []time.Duration{0, -time.Hour} {
+		if _, err := NewIndex(ts, bucket); err != ErrInvalidBucket {
+			t.Errorf("NewIndex(ts, %v) error = %v, want ErrInvalidBucket", bucket, err)
+		}
+	}
+}
+
+func TestNewIndexBucketTooFine(t *testing.T) {
+	events := []testEvent{
+		{Date: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}, // 20 years later
+	}
+	ts := New(events, eventTime)
+	if _, err := NewIndex(ts, time.Microsecond); err != ErrBucketTooFine {
+		t.Errorf("NewIndex() error = %v, want ErrBucketTooFine", err)
+	}
+}
@@ -0,0 +1,168 @@
+package gts
+
+import (
+	"sort"
+	"time"
+	"unsafe"
+)
+
+// insertAllMergeThreshold is the minimum ratio of batch size to existing slice size
+// above which InsertAll switches from one-at-a-time Insert calls to a full linear
+// two-pointer merge.
+const insertAllMergeThreshold = 0.25
+
+// Insert inserts v into the slice while maintaining ascending time order, without
+// re-sorting the rest of the slice. If the slice has not been sorted ascending yet
+// (see SortAsc), it is sorted first.
+func (ts *TimeSlice[T]) Insert(v T) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if !ts.sorted {
+		ts.sortLocked(false)
+	}
+	ts.insertLocked(v)
+	ts.sorted = true
+}
+
+// insertLocked inserts v at its sorted position assuming the caller already holds
+// ts.mu and the slice is already sorted ascending.
+func (ts *TimeSlice[T]) insertLocked(v T) {
+	idx := ts.insertIndexLocked(v)
+	var zero T
+	ts.slice = append(ts.slice, zero)
+	copy(ts.slice[idx+1:], ts.slice[idx:len(ts.slice)-1])
+	ts.slice[idx] = v
+}
+
+// insertIndexLocked returns the index at which v must be inserted to maintain the
+// order ts.compare establishes, assuming the caller already holds ts.mu and the slice
+// is already sorted ascending. It first brackets the run of elements sharing v's
+// extracted time with searchTimeLocked (an O(log n) search by time alone), then
+// narrows within that run with ts.compare, so a comparator with a secondary key (see
+// WithComparator) places v correctly relative to other elements at the same time.
+func (ts *TimeSlice[T]) insertIndexLocked(v T) int {
+	t := ts.fieldTimeExtractor(v)
+	start := ts.searchTimeLocked(t)
+	end := start + sort.Search(len(ts.slice)-start, func(i int) bool {
+		return ts.fieldTimeExtractor(ts.slice[start+i]).After(t)
+	})
+	if start == end {
+		return start
+	}
+	offset := sort.Search(end-start, func(i int) bool {
+		return ts.compare(ts.slice[start+i], v) > 0
+	})
+	return start + offset
+}
+
+// InsertAll inserts every element of vs into the slice while maintaining ascending
+// time order. If the slice has not been sorted ascending yet, it is sorted first. For
+// a batch that is small relative to the existing slice, each element is inserted one
+// at a time via the same O(log n) search as Insert; for a large batch, vs is sorted
+// once and then merged into a new backing slice via a linear two-pointer merge.
+func (ts *TimeSlice[T]) InsertAll(vs []T) {
+	if len(vs) == 0 {
+		return
+	}
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if !ts.sorted {
+		ts.sortLocked(false)
+	}
+
+	batch := make([]T, len(vs))
+	copy(batch, vs)
+	sort.SliceStable(batch, func(i, j int) bool {
+		return ts.compare(batch[i], batch[j]) < 0
+	})
+
+	if float64(len(batch)) < float64(len(ts.slice))*insertAllMergeThreshold {
+		for _, v := range batch {
+			ts.insertLocked(v)
+		}
+		ts.sorted = true
+		return
+	}
+
+	merged := make([]T, 0, len(ts.slice)+len(batch))
+	i, j := 0, 0
+	for i < len(ts.slice) && j < len(batch) {
+		if ts.compare(ts.slice[i], batch[j]) <= 0 {
+			merged = append(merged, ts.slice[i])
+			i++
+		} else {
+			merged = append(merged, batch[j])
+			j++
+		}
+	}
+	merged = append(merged, ts.slice[i:]...)
+	merged = append(merged, batch[j:]...)
+	ts.slice = merged
+	ts.sorted = true
+}
+
+// DeleteBefore removes every element whose extracted time is strictly before t and
+// returns the number of elements removed. If the slice has not been sorted ascending
+// yet, it is sorted first.
+func (ts *TimeSlice[T]) DeleteBefore(t time.Time) int {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if !ts.sorted {
+		ts.sortLocked(false)
+	}
+	idx := ts.searchTimeLocked(t)
+	if idx == 0 {
+		return 0
+	}
+	remaining := make([]T, len(ts.slice)-idx)
+	copy(remaining, ts.slice[idx:])
+	ts.slice = remaining
+	ts.sorted = true
+	return idx
+}
+
+// Merge merges other into ts in place, producing a single slice in ascending time
+// order. Both ts and other are sorted ascending first if they are not already.
+func (ts *TimeSlice[T]) Merge(other *TimeSlice[T]) {
+	if ts == other {
+		return
+	}
+
+	// Lock both in a consistent order based on the mutexes' addresses, regardless of
+	// which side ts/other happen to be, so that a.Merge(b) running concurrently with
+	// b.Merge(a) can never deadlock on a reversed lock acquisition order.
+	first, second := ts, other
+	if uintptr(unsafe.Pointer(&other.mu)) < uintptr(unsafe.Pointer(&ts.mu)) {
+		first, second = other, ts
+	}
+	first.mu.Lock()
+	defer first.mu.Unlock()
+	second.mu.Lock()
+	defer second.mu.Unlock()
+
+	if !other.sorted {
+		other.sortLocked(false)
+	}
+	otherItems := make([]T, len(other.slice))
+	copy(otherItems, other.slice)
+
+	if !ts.sorted {
+		ts.sortLocked(false)
+	}
+
+	merged := make([]T, 0, len(ts.slice)+len(otherItems))
+	i, j := 0, 0
+	for i < len(ts.slice) && j < len(otherItems) {
+		if ts.compare(ts.slice[i], otherItems[j]) <= 0 {
+			merged = append(merged, ts.slice[i])
+			i++
+		} else {
+			merged = append(merged, otherItems[j])
+			j++
+		}
+	}
+	merged = append(merged, ts.slice[i:]...)
+	merged = append(merged, otherItems[j:]...)
+	ts.slice = merged
+	ts.sorted = true
+}
@@ -0,0 +1,139 @@
+package gts
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInsert(t *testing.T) {
+	events := []testEvent{
+		{"A", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"C", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	ts := New(events, eventTime)
+	ts.SortAsc()
+	ts.Insert(testEvent{"B", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	want := []testEvent{
+		{"A", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"B", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"C", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(ts.Items(), want) {
+		t.Errorf("Insert() got %v, want %v", ts.Items(), want)
+	}
+	if idx, err := ts.SearchTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)); err != nil || idx != 1 {
+		t.Errorf("SearchTime() after Insert() got (%d, %v), want (1, nil)", idx, err)
+	}
+}
+
+func TestInsertHonorsComparatorTieBreak(t *testing.T) {
+	d := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []testEvent{
+		{"A", d},
+		{"C", d},
+	}
+	ts := New(events, eventTime, WithComparator(func(a, b testEvent) int {
+		if c := a.Date.Compare(b.Date); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	}))
+	ts.SortAsc()
+	ts.Insert(testEvent{"B", d})
+
+	want := []testEvent{{"A", d}, {"B", d}, {"C", d}}
+	if !reflect.DeepEqual(ts.Items(), want) {
+		t.Errorf("Insert() with WithComparator got %v, want %v", ts.Items(), want)
+	}
+}
+
+func TestInsertAllSmallBatch(t *testing.T) {
+	ts := New(generateLargeEvents(100), eventTime)
+	ts.SortAsc()
+	ts.InsertAll([]testEvent{
+		{"X", time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC)},
+		{"Y", time.Date(2020, 1, 1, 1, 15, 0, 0, time.UTC)},
+	})
+	if len(ts.Items()) != 102 {
+		t.Fatalf("InsertAll() len = %d, want 102", len(ts.Items()))
+	}
+	if !sort.SliceIsSorted(ts.Items(), func(i, j int) bool {
+		return ts.Items()[i].Date.Before(ts.Items()[j].Date)
+	}) {
+		t.Error("InsertAll() with a small batch did not keep the slice sorted")
+	}
+}
+
+func TestInsertAllLargeBatchMerges(t *testing.T) {
+	ts := New(generateLargeEvents(10), eventTime)
+	ts.SortAsc()
+	ts.InsertAll(generateLargeEvents(1000))
+	if len(ts.Items()) != 1010 {
+		t.Fatalf("InsertAll() len = %d, want 1010", len(ts.Items()))
+	}
+	if !ts.sorted {
+		t.Error("InsertAll() with a large batch should leave the slice marked sorted")
+	}
+}
+
+func TestDeleteBefore(t *testing.T) {
+	events := []testEvent{
+		{"A", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"B", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"C", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	ts := New(events, eventTime)
+	ts.SortAsc()
+	removed := ts.DeleteBefore(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	if removed != 1 {
+		t.Errorf("DeleteBefore() removed = %d, want 1", removed)
+	}
+	want := []testEvent{events[1], events[2]}
+	if !reflect.DeepEqual(ts.Items(), want) {
+		t.Errorf("DeleteBefore() got %v, want %v", ts.Items(), want)
+	}
+}
+
+func TestMergeConcurrentDoesNotDeadlock(t *testing.T) {
+	a := New([]testEvent{{"A", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}}, eventTime)
+	b := New([]testEvent{{"B", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}}, eventTime)
+
+	done := make(chan struct{}, 2)
+	go func() { a.Merge(b); done <- struct{}{} }()
+	go func() { b.Merge(a); done <- struct{}{} }()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("a.Merge(b) and b.Merge(a) running concurrently deadlocked")
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a := New([]testEvent{
+		{"A", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"C", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, eventTime)
+	b := New([]testEvent{
+		{"B", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"D", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}, eventTime)
+
+	a.Merge(b)
+
+	want := []testEvent{
+		{"A", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"B", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"C", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"D", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	if !reflect.DeepEqual(a.Items(), want) {
+		t.Errorf("Merge() got %v, want %v", a.Items(), want)
+	}
+}
@@ -0,0 +1,168 @@
+package gts
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSortAscParallelMatchesSortAsc(t *testing.T) {
+	events := generateLargeEvents(60000) // above minParallelChunk so the parallel path actually runs
+
+	sequential := New(append([]testEvent(nil), events...), eventTime)
+	sequential.SortAsc()
+
+	parallel := New(append([]testEvent(nil), events...), eventTime)
+	parallel.SortAscParallel(4)
+
+	if !reflect.DeepEqual(sequential.Items(), parallel.Items()) {
+		t.Error("SortAscParallel() did not produce the same order as SortAsc()")
+	}
+	if !parallel.sorted {
+		t.Error("SortAscParallel() should mark the slice as sorted ascending")
+	}
+}
+
+func TestSortDescParallelMatchesSortDesc(t *testing.T) {
+	events := generateLargeEvents(60000) // above minParallelChunk so the parallel path actually runs
+
+	sequential := New(append([]testEvent(nil), events...), eventTime)
+	sequential.SortDesc()
+
+	parallel := New(append([]testEvent(nil), events...), eventTime)
+	parallel.SortDescParallel(4)
+
+	if !reflect.DeepEqual(sequential.Items(), parallel.Items()) {
+		t.Error("SortDescParallel() did not produce the same order as SortDesc()")
+	}
+}
+
+func TestSortAscParallelSmallSliceFallsBack(t *testing.T) {
+	events := []testEvent{
+		{"B", generateLargeEvents(1)[0].Date.Add(0)},
+		{"A", generateLargeEvents(1)[0].Date.Add(-1)},
+	}
+	ts := New(events, eventTime)
+	ts.SortAscParallel(4)
+	if ts.slice[0].Name != "A" {
+		t.Errorf("SortAscParallel() on a small slice got %v, want A first", ts.Items())
+	}
+}
+
+func TestSortAscParallelDefaultParallelism(t *testing.T) {
+	SetDefaultParallelism(2)
+	events := generateLargeEvents(60000) // above minParallelChunk so the parallel path actually runs
+
+	want := New(append([]testEvent(nil), events...), eventTime)
+	want.SortAsc()
+
+	got := New(events, eventTime)
+	got.SortAscParallel(0)
+
+	if !reflect.DeepEqual(want.Items(), got.Items()) {
+		t.Error("SortAscParallel(0) with default parallelism did not sort correctly")
+	}
+}
+
+func TestSortAscParallelDoesNotAliasCallerSlice(t *testing.T) {
+	events := generateLargeEvents(60000) // above minParallelChunk so the parallel path actually runs
+	// Reverse so ascending sort actually reorders elements, rather than leaving the
+	// already-ascending fixture unchanged and masking any aliasing corruption.
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	callerSlice := append([]testEvent(nil), events...)
+	want := append([]testEvent(nil), events...)
+
+	ts := New(callerSlice, eventTime)
+	ts.SortAscParallel(4)
+	ts.SortAscParallel(4) // a second call is what exposed the caller's slice as scratch space
+
+	if !reflect.DeepEqual(callerSlice, want) {
+		t.Error("SortAscParallel() overwrote the caller's original backing array as internal scratch space")
+	}
+}
+
+func TestGetWorkerPoolCacheIsBounded(t *testing.T) {
+	poolsMu.Lock()
+	pools = map[int]*workerPool{}
+	poolsOrder = nil
+	poolsMu.Unlock()
+
+	for workers := 1; workers <= maxCachedPools+3; workers++ {
+		getWorkerPool(workers)
+	}
+
+	poolsMu.Lock()
+	got := len(pools)
+	poolsMu.Unlock()
+	if got > maxCachedPools {
+		t.Errorf("len(pools) = %d, want at most %d", got, maxCachedPools)
+	}
+
+	// The most recently used entries should have survived eviction.
+	poolsMu.Lock()
+	_, recentCached := pools[maxCachedPools+3]
+	poolsMu.Unlock()
+	if !recentCached {
+		t.Error("getWorkerPool evicted a recently used entry instead of the least-recently-used one")
+	}
+}
+
+func TestGetWorkerPoolStopsEvictedPools(t *testing.T) {
+	poolsMu.Lock()
+	pools = map[int]*workerPool{}
+	poolsOrder = nil
+	poolsMu.Unlock()
+
+	first := getWorkerPool(1)
+	for workers := 2; workers <= maxCachedPools+1; workers++ {
+		getWorkerPool(workers)
+	}
+
+	select {
+	case <-first.closed:
+	default:
+		t.Error("getWorkerPool evicted a pool without stopping its goroutines")
+	}
+}
+
+func TestSortAscParallelHonorsComparatorTieBreak(t *testing.T) {
+	events := generateLargeEvents(60000) // above minParallelChunk so the parallel path actually runs
+	for i := range events {
+		events[i].Date = events[i].Date.Truncate(24 * time.Hour) // force ties within each day
+	}
+	cmp := WithComparator(func(a, b testEvent) int {
+		if c := a.Date.Compare(b.Date); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	sequential := New(append([]testEvent(nil), events...), eventTime, cmp)
+	sequential.SortAsc()
+
+	parallel := New(append([]testEvent(nil), events...), eventTime, cmp)
+	parallel.SortAscParallel(4)
+
+	if !reflect.DeepEqual(sequential.Items(), parallel.Items()) {
+		t.Error("SortAscParallel() did not honor the WithComparator tie-break on equal times")
+	}
+}
+
+// These are named to line up with BenchmarkSortAsc_* in gts_test.go so
+// `go test -bench . -benchmem` prints matched pairs for comparison.
+func BenchmarkSortAscParallel_10000(b *testing.B)  { benchmarkSortAscParallel(b, 10000) }
+func BenchmarkSortAscParallel_50000(b *testing.B)  { benchmarkSortAscParallel(b, 50000) }
+func BenchmarkSortAscParallel_100000(b *testing.B) { benchmarkSortAscParallel(b, 100000) }
+
+func benchmarkSortAscParallel(b *testing.B, n int) {
+	events := generateLargeEvents(n)
+	ts := New(events, eventTime)
+	workers := getDefaultParallelism()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ts.SortAscParallel(workers)
+	}
+}